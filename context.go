@@ -0,0 +1,68 @@
+package prompter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/mattn/go-isatty"
+	"golang.org/x/term"
+)
+
+// ErrInterrupted is returned by PromptContext when the prompt is
+// cancelled by SIGINT (Ctrl-C).
+var ErrInterrupted = errors.New("prompter: interrupted")
+
+// PromptContext behaves like PromptE but returns early with ctx.Err()
+// if ctx is cancelled, and with ErrInterrupted on Ctrl-C. In both
+// cases, if the prompt was reading a NoEcho (password) field, the
+// terminal's echo/cooked mode is restored before returning so a
+// cancelled prompt never leaves the terminal in a broken state.
+//
+// Unlike spawning the read in a goroutine and abandoning it on
+// timeout, the read here runs on the calling goroutine and is itself
+// ctx-aware all the way down to the shared stdin reader (stdin.go):
+// cancelling ctx makes the blocked read return immediately instead of
+// leaving a second reader competing with whatever prompt comes next.
+func (p *Prompter) PromptContext(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT)
+	defer signal.Stop(sigCh)
+
+	interrupted := make(chan struct{}, 1)
+	go func() {
+		select {
+		case <-sigCh:
+			interrupted <- struct{}{}
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var state *term.State
+	if p.NoEcho && isatty.IsTerminal(os.Stdin.Fd()) {
+		state, _ = term.GetState(int(os.Stdin.Fd()))
+	}
+
+	answer, err := p.promptCtx(ctx)
+	if err == nil {
+		return answer, nil
+	}
+
+	if state != nil {
+		term.Restore(int(os.Stdin.Fd()), state)
+	}
+	select {
+	case <-interrupted:
+		fmt.Println()
+		return "", ErrInterrupted
+	default:
+		return "", err
+	}
+}
@@ -0,0 +1,150 @@
+package prompter
+
+import (
+	"context"
+	"unicode/utf8"
+)
+
+// key identifies a parsed keystroke from the raw-mode input stream,
+// shared by Select and MultiSelect.
+type key int
+
+const (
+	keyRune key = iota
+	keyUp
+	keyDown
+	keyLeft
+	keyRight
+	keyPgUp
+	keyPgDn
+	keyEnter
+	keyBackspace
+	keySpace
+	keyTab
+	keyHome
+	keyEnd
+	keyWordDelete
+)
+
+// byteSource is anything readKey can pull single bytes from: a
+// *bufio.Reader over a strings.Reader in tests, or ctxByteSource
+// (below) over the process's single shared stdin reader in
+// production — so a cancelled caller never has to open a second,
+// competing read of the real stdin.
+type byteSource interface {
+	ReadByte() (byte, error)
+}
+
+// ctxByteSource adapts the shared stdin reader to byteSource, making
+// each read cancellable via ctx.
+type ctxByteSource struct {
+	ctx context.Context
+}
+
+func (c ctxByteSource) ReadByte() (byte, error) {
+	return readByteCtx(c.ctx)
+}
+
+// stdinKeys returns the byteSource that raw-mode prompts (Select,
+// MultiSelect, the line editor, password reads) use to read from the
+// real terminal.
+func stdinKeys(ctx context.Context) byteSource {
+	return ctxByteSource{ctx: ctx}
+}
+
+// readKey reads and classifies a single keystroke, resolving ANSI
+// escape sequences (ESC [ A/B/C/D for arrows, ESC [ 5/6 ~ for
+// PgUp/PgDn) into the key constants above. For keyRune it also
+// returns the decoded rune. Ctrl-C is returned as ErrInterrupted.
+func readKey(r byteSource) (key, rune, error) {
+	b, err := r.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+	switch b {
+	case 3: // Ctrl-C: raw mode turns off SIGINT, so report it here
+		return 0, 0, ErrInterrupted
+	case '\r', '\n':
+		return keyEnter, 0, nil
+	case 127, 8:
+		return keyBackspace, 0, nil
+	case 23: // Ctrl-W
+		return keyWordDelete, 0, nil
+	case ' ':
+		return keySpace, ' ', nil
+	case '\t':
+		return keyTab, 0, nil
+	case 0x1b:
+		b2, err := r.ReadByte()
+		if err != nil || b2 != '[' {
+			return keyRune, 0x1b, nil
+		}
+		b3, err := r.ReadByte()
+		if err != nil {
+			return 0, 0, err
+		}
+		switch b3 {
+		case 'A':
+			return keyUp, 0, nil
+		case 'B':
+			return keyDown, 0, nil
+		case 'C':
+			return keyRight, 0, nil
+		case 'D':
+			return keyLeft, 0, nil
+		case 'H':
+			return keyHome, 0, nil
+		case 'F':
+			return keyEnd, 0, nil
+		case '1', '4', '5', '6':
+			page := b3
+			if _, err := r.ReadByte(); err != nil { // trailing '~'
+				return 0, 0, err
+			}
+			switch page {
+			case '1':
+				return keyHome, 0, nil
+			case '4':
+				return keyEnd, 0, nil
+			case '5':
+				return keyPgUp, 0, nil
+			default:
+				return keyPgDn, 0, nil
+			}
+		default:
+			return keyRune, 0, nil
+		}
+	default:
+		if b < utf8.RuneSelf {
+			return keyRune, rune(b), nil
+		}
+		return readUTF8Rune(r, b)
+	}
+}
+
+// readUTF8Rune decodes a multi-byte UTF-8 sequence whose lead byte b
+// has already been consumed from r.
+func readUTF8Rune(r byteSource, b byte) (key, rune, error) {
+	n := 0
+	switch {
+	case b&0xE0 == 0xC0:
+		n = 1
+	case b&0xF0 == 0xE0:
+		n = 2
+	case b&0xF8 == 0xF0:
+		n = 3
+	default:
+		return keyRune, utf8.RuneError, nil
+	}
+	buf := make([]byte, 1, 1+n)
+	buf[0] = b
+	for i := 0; i < n; i++ {
+		next, err := r.ReadByte()
+		if err != nil {
+			return 0, 0, err
+		}
+		buf = append(buf, next)
+	}
+	rn, _ := utf8.DecodeRune(buf)
+	return keyRune, rn, nil
+}
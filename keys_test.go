@@ -0,0 +1,98 @@
+package prompter
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func readAllKeys(t *testing.T, input string) []key {
+	t.Helper()
+	r := bufio.NewReader(strings.NewReader(input))
+	var keys []key
+	for {
+		k, _, err := readKey(r)
+		if err != nil {
+			break
+		}
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestReadKeyArrows(t *testing.T) {
+	cases := map[string]key{
+		"\x1b[A":  keyUp,
+		"\x1b[B":  keyDown,
+		"\x1b[C":  keyRight,
+		"\x1b[D":  keyLeft,
+		"\x1b[H":  keyHome,
+		"\x1b[F":  keyEnd,
+		"\x1b[1~": keyHome,
+		"\x1b[4~": keyEnd,
+		"\x1b[5~": keyPgUp,
+		"\x1b[6~": keyPgDn,
+	}
+	for input, want := range cases {
+		keys := readAllKeys(t, input)
+		if len(keys) != 1 || keys[0] != want {
+			t.Errorf("readKey(%q) = %v, want [%v]", input, keys, want)
+		}
+	}
+}
+
+func TestReadKeyControl(t *testing.T) {
+	cases := map[string]key{
+		"\r":   keyEnter,
+		"\n":   keyEnter,
+		"\x7f": keyBackspace,
+		"\b":   keyBackspace,
+		"\x17": keyWordDelete,
+		" ":    keySpace,
+		"\t":   keyTab,
+	}
+	for input, want := range cases {
+		keys := readAllKeys(t, input)
+		if len(keys) != 1 || keys[0] != want {
+			t.Errorf("readKey(%q) = %v, want [%v]", input, keys, want)
+		}
+	}
+}
+
+func TestReadKeyRune(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("a"))
+	k, rn, err := readKey(r)
+	if err != nil {
+		t.Fatalf("readKey: %v", err)
+	}
+	if k != keyRune || rn != 'a' {
+		t.Errorf("readKey(\"a\") = (%v, %q), want (keyRune, 'a')", k, rn)
+	}
+}
+
+func TestReadKeyUTF8(t *testing.T) {
+	cases := []string{"é", "日", "😀"}
+	for _, want := range cases {
+		r := bufio.NewReader(strings.NewReader(want))
+		k, rn, err := readKey(r)
+		if err != nil {
+			t.Fatalf("readKey(%q): %v", want, err)
+		}
+		if k != keyRune || string(rn) != want {
+			t.Errorf("readKey(%q) = (%v, %q), want (keyRune, %q)", want, k, rn, want)
+		}
+	}
+}
+
+func TestReadKeySequence(t *testing.T) {
+	keys := readAllKeys(t, "ab\x1b[A\r")
+	want := []key{keyRune, keyRune, keyUp, keyEnter}
+	if len(keys) != len(want) {
+		t.Fatalf("readAllKeys = %v, want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Errorf("keys[%d] = %v, want %v", i, keys[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package prompter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// wantsLineEdit reports whether this prompt should use the raw-mode
+// line editor instead of plain bufio.Scanner reads: only on free-form
+// prompts (Choices and Regexp unset), and only when there's a
+// Completer or History to drive.
+func (p *Prompter) wantsLineEdit() bool {
+	if len(p.Choices) > 0 || p.Regexp != nil {
+		return false
+	}
+	return p.Completer != nil || len(p.History) > 0 || p.HistoryFile != ""
+}
+
+// readLine runs a minimal readline: Left/Right/Home/End move the
+// cursor, Backspace and Ctrl-W delete, Tab completes via Completer,
+// and Up/Down walk History. It returns the line the user accepted
+// with Enter.
+func (p *Prompter) readLine(ctx context.Context) (string, error) {
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return readLineCtx(ctx)
+	}
+	defer term.Restore(fd, oldState)
+
+	p.loadHistoryFile()
+	history := append(append([]string{}, p.History...), p.history...)
+	histPos := len(history)
+
+	line := []rune{}
+	pos := 0
+	suggestion := ""
+
+	in := stdinKeys(ctx)
+
+	redraw := func() {
+		fmt.Print("\r\033[K" + p.msg() + string(line))
+		if suggestion != "" {
+			fmt.Print("\n" + suggestion + "\033[J\033[1A")
+		} else {
+			fmt.Print("\033[J")
+		}
+		if back := len(line) - pos; back > 0 {
+			fmt.Printf("\033[%dD", back)
+		}
+	}
+	redraw()
+
+	for {
+		k, r, err := readKey(in)
+		if err != nil {
+			return "", err
+		}
+		suggestion = ""
+		switch k {
+		case keyEnter:
+			fmt.Print("\n")
+			answer := string(line)
+			p.addHistory(answer)
+			return answer, nil
+		case keyBackspace:
+			if pos > 0 {
+				line = append(line[:pos-1], line[pos:]...)
+				pos--
+			}
+		case keyWordDelete:
+			start := pos
+			for start > 0 && line[start-1] == ' ' {
+				start--
+			}
+			for start > 0 && line[start-1] != ' ' {
+				start--
+			}
+			line = append(line[:start], line[pos:]...)
+			pos = start
+		case keyLeft:
+			if pos > 0 {
+				pos--
+			}
+		case keyRight:
+			if pos < len(line) {
+				pos++
+			}
+		case keyHome:
+			pos = 0
+		case keyEnd:
+			pos = len(line)
+		case keyUp:
+			if histPos > 0 {
+				histPos--
+				line = []rune(history[histPos])
+				pos = len(line)
+			}
+		case keyDown:
+			if histPos < len(history)-1 {
+				histPos++
+				line = []rune(history[histPos])
+				pos = len(line)
+			} else if histPos < len(history) {
+				histPos++
+				line = []rune{}
+				pos = 0
+			}
+		case keyTab:
+			if p.Completer == nil {
+				break
+			}
+			candidates := p.Completer(string(line[:pos]))
+			switch len(candidates) {
+			case 0:
+				// no match
+			case 1:
+				line = append([]rune(candidates[0]), line[pos:]...)
+				pos = len([]rune(candidates[0]))
+			default:
+				suggestion = strings.Join(candidates, "  ")
+			}
+		case keySpace:
+			line = append(line[:pos], append([]rune{' '}, line[pos:]...)...)
+			pos++
+		case keyRune:
+			line = append(line[:pos], append([]rune{r}, line[pos:]...)...)
+			pos++
+		}
+		redraw()
+	}
+}
+
+func (p *Prompter) addHistory(line string) {
+	if line == "" {
+		return
+	}
+	p.history = append(p.history, line)
+	if p.HistoryFile != "" {
+		f, err := os.OpenFile(p.HistoryFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		if err == nil {
+			fmt.Fprintln(f, line)
+			f.Close()
+		}
+	}
+}
+
+func (p *Prompter) loadHistoryFile() {
+	if p.HistoryFile == "" || p.historyLoaded {
+		return
+	}
+	p.historyLoaded = true
+	b, err := os.ReadFile(p.HistoryFile)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(strings.TrimRight(string(b), "\n"), "\n") {
+		if line != "" {
+			p.history = append(p.history, line)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package prompter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// MultiSelect is a checkbox-style prompt: the user toggles any number
+// of Choices with Space, confirms with Enter, and can toggle every
+// visible choice at once with 'a'. It shares Select's arrow-key
+// rendering pipeline.
+type MultiSelect struct {
+	Message  string
+	Choices  []string
+	Default  []string
+	Min      int
+	Max      int
+	PageSize int
+
+	SearchFunc func(input, item string) bool
+	Templates  *SelectTemplates
+}
+
+// Run renders the checkbox list and returns the indexes into Choices
+// and their values, in Choices order, or an error if the terminal
+// couldn't be read.
+func (m *MultiSelect) Run() ([]int, []string, error) {
+	if skip() {
+		return m.fallback()
+	}
+
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return m.fallback()
+	}
+	defer term.Restore(fd, oldState)
+
+	checked := make(map[string]bool, len(m.Default))
+	for _, d := range m.Default {
+		checked[d] = true
+	}
+
+	cursor := 0
+	top := 0
+	filter := ""
+	pageSize := m.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	fmt.Print(m.Message + "\n")
+	linesDrawn := 0
+	complaint := ""
+	in := stdinKeys(context.Background())
+
+	visible := func() []string {
+		if filter == "" {
+			return m.Choices
+		}
+		search := m.searchFunc()
+		out := make([]string, 0, len(m.Choices))
+		for _, c := range m.Choices {
+			if search(filter, c) {
+				out = append(out, c)
+			}
+		}
+		return out
+	}
+
+	redraw := func() {
+		vis := visible()
+		if cursor >= len(vis) {
+			cursor = len(vis) - 1
+		}
+		if cursor < 0 {
+			cursor = 0
+		}
+		if cursor < top {
+			top = cursor
+		}
+		if cursor >= top+pageSize {
+			top = cursor - pageSize + 1
+		}
+		if linesDrawn > 0 {
+			fmt.Printf("\033[%dA", linesDrawn)
+		}
+		linesDrawn = 0
+		fmt.Printf("\r\033[KFilter: %s\n", filter)
+		linesDrawn++
+		end := top + pageSize
+		if end > len(vis) {
+			end = len(vis)
+		}
+		for i := top; i < end; i++ {
+			fmt.Printf("\r\033[K%s\n", m.render(vis[i], i == cursor, checked[vis[i]]))
+			linesDrawn++
+		}
+		if complaint != "" {
+			fmt.Printf("\r\033[K# %s\n", complaint)
+			linesDrawn++
+			complaint = ""
+		}
+		fmt.Print("\033[J")
+	}
+
+	redraw()
+	for {
+		key, r, err := readKey(in)
+		if err != nil {
+			return nil, nil, err
+		}
+		vis := visible()
+		switch key {
+		case keyUp:
+			if cursor > 0 {
+				cursor--
+			}
+		case keyDown:
+			if cursor < len(vis)-1 {
+				cursor++
+			}
+		case keyPgUp:
+			cursor -= pageSize
+			if cursor < 0 {
+				cursor = 0
+			}
+		case keyPgDn:
+			cursor += pageSize
+			if cursor > len(vis)-1 {
+				cursor = len(vis) - 1
+			}
+		case keySpace:
+			if len(vis) > 0 {
+				choice := vis[cursor]
+				checked[choice] = !checked[choice]
+			}
+		case keyEnter:
+			n := 0
+			for _, c := range m.Choices {
+				if checked[c] {
+					n++
+				}
+			}
+			if m.Min > 0 && n < m.Min {
+				complaint = fmt.Sprintf("Select at least %d", m.Min)
+				redraw()
+				continue
+			}
+			if m.Max > 0 && n > m.Max {
+				complaint = fmt.Sprintf("Select at most %d", m.Max)
+				redraw()
+				continue
+			}
+			fmt.Print("\r\n")
+			return m.result(checked)
+		case keyRune:
+			if r == 'a' {
+				all := true
+				for _, c := range vis {
+					if !checked[c] {
+						all = false
+						break
+					}
+				}
+				for _, c := range vis {
+					checked[c] = !all
+				}
+				break
+			}
+			filter += string(r)
+			cursor = 0
+			top = 0
+		case keyBackspace:
+			if runes := []rune(filter); len(runes) > 0 {
+				filter = string(runes[:len(runes)-1])
+				cursor = 0
+				top = 0
+			}
+		}
+		redraw()
+	}
+}
+
+func (m *MultiSelect) result(checked map[string]bool) ([]int, []string, error) {
+	var idx []int
+	var values []string
+	for i, c := range m.Choices {
+		if checked[c] {
+			idx = append(idx, i)
+			values = append(values, c)
+		}
+	}
+	return idx, values, nil
+}
+
+func (m *MultiSelect) fallback() ([]int, []string, error) {
+	checked := make(map[string]bool, len(m.Default))
+	for _, d := range m.Default {
+		checked[d] = true
+	}
+	if m.Min > 0 && len(m.Default) < m.Min {
+		return nil, nil, fmt.Errorf("prompter: default selection has %d items, need at least %d", len(m.Default), m.Min)
+	}
+	if m.Max > 0 && len(m.Default) > m.Max {
+		return nil, nil, fmt.Errorf("prompter: default selection has %d items, want at most %d", len(m.Default), m.Max)
+	}
+	return m.result(checked)
+}
+
+func (m *MultiSelect) searchFunc() func(input, item string) bool {
+	if m.SearchFunc != nil {
+		return m.SearchFunc
+	}
+	return func(input, item string) bool {
+		return strings.Contains(strings.ToLower(item), strings.ToLower(input))
+	}
+}
+
+func (m *MultiSelect) render(choice string, active, checked bool) string {
+	box := "[ ]"
+	if checked {
+		box = "[x]"
+	}
+	t := m.Templates
+	if checked && t != nil && t.Selected != "" {
+		return fmt.Sprintf(t.Selected, choice)
+	}
+	if active && t != nil && t.Active != "" {
+		return box + " " + fmt.Sprintf(t.Active, choice)
+	}
+	if !active && t != nil && t.Inactive != "" {
+		return box + " " + fmt.Sprintf(t.Inactive, choice)
+	}
+	cursor := "  "
+	if active {
+		cursor = "> "
+	}
+	return cursor + box + " " + choice
+}
@@ -0,0 +1,121 @@
+package prompter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"unicode"
+
+	"golang.org/x/term"
+)
+
+// PasswordPolicy validates a NoEcho answer before it's accepted.
+// Zero-valued requirements are not enforced. Score, if set, is an
+// additional pluggable check: a return value below 0 fails the
+// policy (e.g. wired up to a zxcvbn-style scorer).
+type PasswordPolicy struct {
+	MinLength     int
+	RequireDigit  bool
+	RequireUpper  bool
+	RequireSymbol bool
+	Score         func(string) int
+}
+
+func (pp *PasswordPolicy) check(password string) string {
+	if pp.MinLength > 0 && len([]rune(password)) < pp.MinLength {
+		return fmt.Sprintf("Password must be at least %d characters", pp.MinLength)
+	}
+	var hasDigit, hasUpper, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+	if pp.RequireDigit && !hasDigit {
+		return "Password must contain a digit"
+	}
+	if pp.RequireUpper && !hasUpper {
+		return "Password must contain an uppercase letter"
+	}
+	if pp.RequireSymbol && !hasSymbol {
+		return "Password must contain a symbol"
+	}
+	if pp.Score != nil && pp.Score(password) < 0 {
+		return "Password is too weak"
+	}
+	return ""
+}
+
+// readPassword reads one NoEcho answer, applying MaskChar echoing
+// and PasswordPolicy, and Confirm re-entry.
+func (p *Prompter) readPassword(ctx context.Context) (string, error) {
+	pw, err := p.readOnePassword(ctx)
+	if err != nil {
+		return "", err
+	}
+	if p.PasswordPolicy != nil {
+		if complaint := p.PasswordPolicy.check(pw); complaint != "" {
+			fmt.Println("# " + complaint)
+			fmt.Print(p.msg())
+			return p.readPassword(ctx)
+		}
+	}
+	if p.Confirm {
+		fmt.Print("Confirm: ")
+		confirm, err := p.readOnePassword(ctx)
+		if err != nil {
+			return "", err
+		}
+		if confirm != pw {
+			fmt.Println("# Passwords didn't match")
+			fmt.Print(p.msg())
+			return p.readPassword(ctx)
+		}
+	}
+	return pw, nil
+}
+
+// readOnePassword reads a single password entry in raw mode, not
+// echoing typed characters unless MaskChar is set.
+func (p *Prompter) readOnePassword(ctx context.Context) (string, error) {
+	echo := ""
+	if p.MaskChar != 0 {
+		echo = string(p.MaskChar)
+	}
+
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return "", err
+	}
+	defer term.Restore(fd, oldState)
+
+	var pw []rune
+	in := stdinKeys(ctx)
+	for {
+		k, r, err := readKey(in)
+		if err != nil {
+			return "", err
+		}
+		switch k {
+		case keyEnter:
+			fmt.Print("\r\n")
+			return string(pw), nil
+		case keyBackspace:
+			if len(pw) > 0 {
+				pw = pw[:len(pw)-1]
+				if echo != "" {
+					fmt.Print("\b \b")
+				}
+			}
+		case keyRune, keySpace:
+			pw = append(pw, r)
+			fmt.Print(echo)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package prompter
+
+import "testing"
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	cases := []struct {
+		name     string
+		policy   PasswordPolicy
+		password string
+		wantOK   bool
+	}{
+		{"min length ok", PasswordPolicy{MinLength: 8}, "longenough", true},
+		{"min length short", PasswordPolicy{MinLength: 8}, "short", false},
+		{"min length counts runes", PasswordPolicy{MinLength: 3}, "éé", false},
+		{"digit required missing", PasswordPolicy{RequireDigit: true}, "abcdef", false},
+		{"digit required present", PasswordPolicy{RequireDigit: true}, "abc1ef", true},
+		{"upper required missing", PasswordPolicy{RequireUpper: true}, "abcdef", false},
+		{"upper required present", PasswordPolicy{RequireUpper: true}, "abcDef", true},
+		{"symbol required missing", PasswordPolicy{RequireSymbol: true}, "abcdef1", false},
+		{"symbol required present", PasswordPolicy{RequireSymbol: true}, "abc!def", true},
+		{"score rejects", PasswordPolicy{Score: func(string) int { return -1 }}, "whatever", false},
+		{"score accepts", PasswordPolicy{Score: func(string) int { return 10 }}, "whatever", true},
+		{"no requirements", PasswordPolicy{}, "", true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			complaint := c.policy.check(c.password)
+			if (complaint == "") != c.wantOK {
+				t.Errorf("check(%q) = %q, want ok: %v", c.password, complaint, c.wantOK)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 package prompter
 
 import (
-	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -10,7 +10,6 @@ import (
 	"strings"
 
 	"github.com/mattn/go-isatty"
-	"golang.org/x/crypto/ssh/terminal"
 )
 
 // VERSION version of prompter
@@ -27,32 +26,87 @@ type Prompter struct {
 	// specify answer pattern by regexp. When both Choices and Regexp are specified, Regexp takes a priority.
 	Regexp *regexp.Regexp
 	// for passwords and so on.
-	NoEcho     bool
-	UseDefault bool
-	IsMenu     bool
-	MenuPrompt string
-	reg        *regexp.Regexp
+	NoEcho bool
+	// Confirm re-asks a NoEcho prompt as "Confirm: " and re-asks both
+	// entries if they don't match.
+	Confirm bool
+	// MaskChar, if set, echoes one rune per typed character instead
+	// of nothing, by reading bytes from a raw-mode fd.
+	MaskChar rune
+	// PasswordPolicy, if set, validates a NoEcho answer before it's
+	// accepted; failures re-prompt without echoing the rejected
+	// password back.
+	PasswordPolicy *PasswordPolicy
+	UseDefault     bool
+	IsMenu         bool
+	MenuPrompt     string
+	// Validate is a convenience for a single validator. It runs alongside
+	// Validators, after the regexp/choices check has passed.
+	Validate func(input string) (complaint string, err error)
+	// Validators run in order after the existing regexp/choices check.
+	// A non-empty complaint is printed and causes a re-prompt; a non-nil
+	// err is fatal and is returned from PromptE instead of looping forever.
+	Validators []func(input string) (complaint string, err error)
+	// Completer, if set, enables Tab-completion on free-form prompts
+	// (Choices and Regexp unset). It's given the line up to the
+	// cursor and returns the matching candidates.
+	Completer func(prefix string) []string
+	// History seeds the Up/Down-walkable history; new answers are
+	// appended in memory for the lifetime of the Prompter. HistoryFile,
+	// if set, also persists and reloads history across runs.
+	History     []string
+	HistoryFile string
+
+	reg           *regexp.Regexp
+	history       []string
+	historyLoaded bool
 }
 
-// Prompt displays a prompt and returns answer
+// Prompt displays a prompt and returns answer. On any error from
+// PromptE — including EOF — it falls back to Default, matching the
+// original Prompt behavior; use PromptE if the caller needs to
+// distinguish EOF, I/O errors, and validator errors from each other.
 func (p *Prompter) Prompt() string {
+	answer, err := p.PromptE()
+	if err != nil {
+		return p.Default
+	}
+	return answer
+}
+
+// PromptE displays a prompt and returns the answer, or an error if
+// reading stdin failed or a validator returned a fatal error.
+func (p *Prompter) PromptE() (string, error) {
+	return p.promptCtx(context.Background())
+}
+
+// promptCtx is PromptE with a context threaded down to every stdin
+// read, so PromptContext can cancel a hung prompt without leaving a
+// read blocked on the shared stdin reader (see stdin.go).
+func (p *Prompter) promptCtx(ctx context.Context) (string, error) {
 	fmt.Print(p.msg())
 	if p.UseDefault || skip() {
-		return p.Default
+		return p.Default, nil
 	}
 	input := ""
 	if p.NoEcho {
-		b, err := terminal.ReadPassword(int(os.Stdin.Fd()))
-		if err == nil {
-			input = string(b)
+		pw, err := p.readPassword(ctx)
+		if err != nil {
+			return "", err
+		}
+		input = pw
+	} else if p.wantsLineEdit() {
+		line, err := p.readLine(ctx)
+		if err != nil {
+			return "", err
 		}
-		fmt.Print("\n")
+		input = line
 	} else {
-		scanner := bufio.NewScanner(os.Stdin)
-		ok := scanner.Scan()
-		if ok {
-			input = strings.TrimRight(scanner.Text(), "\r\n")
+		line, err := readLineCtx(ctx)
+		if err != nil {
+			return "", err
 		}
+		input = line
 	}
 	if input == "" {
 		if p.IsMenu {
@@ -66,9 +120,27 @@ func (p *Prompter) Prompt() string {
 		if p.IsMenu {
 			print("\033[H\033[2J")
 		}
-		return p.Prompt()
+		return p.promptCtx(ctx)
+	}
+	for _, validate := range p.validators() {
+		complaint, err := validate(input)
+		if err != nil {
+			return "", err
+		}
+		if complaint != "" {
+			fmt.Println("# " + complaint)
+			return p.promptCtx(ctx)
+		}
+	}
+	return input, nil
+}
+
+// validators returns Validators with Validate appended, if set.
+func (p *Prompter) validators() []func(input string) (string, error) {
+	if p.Validate == nil {
+		return p.Validators
 	}
-	return input
+	return append(append([]func(string) (string, error){}, p.Validators...), p.Validate)
 }
 
 func skip() bool {
@@ -0,0 +1,218 @@
+package prompter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// DefaultPageSize is how many choices Select shows at once when PageSize
+// is left at zero.
+const DefaultPageSize = 5
+
+// SelectTemplates lets callers colorize the rendered choices. Each
+// template is applied to a single choice string; an empty template
+// falls back to the built-in, uncolored rendering of that choice.
+type SelectTemplates struct {
+	Active   string
+	Inactive string
+	Selected string
+}
+
+// Select is an interactive, arrow-key-driven replacement for the
+// numeric menu built into Prompter. When stdin isn't a TTY it falls
+// back to the existing "type a number" behavior.
+type Select struct {
+	Message  string
+	Choices  []string
+	PageSize int
+	// SearchFunc filters Choices as the user types. Default is a
+	// case-insensitive substring match.
+	SearchFunc func(input, item string) bool
+	Templates  *SelectTemplates
+}
+
+// Run renders the menu and returns the index into Choices and the
+// chosen value, or an error if the terminal couldn't be read or the
+// prompt was interrupted.
+func (s *Select) Run() (int, string, error) {
+	if skip() {
+		return s.fallback()
+	}
+
+	fd := int(os.Stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return s.fallback()
+	}
+	defer term.Restore(fd, oldState)
+
+	cursor := 0
+	top := 0
+	filter := ""
+	pageSize := s.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	fmt.Print(s.Message + "\n")
+	linesDrawn := 0
+	in := stdinKeys(context.Background())
+
+	redraw := func() {
+		visible := s.visible(filter)
+		if cursor >= len(visible) {
+			cursor = len(visible) - 1
+		}
+		if cursor < 0 {
+			cursor = 0
+		}
+		if cursor < top {
+			top = cursor
+		}
+		if cursor >= top+pageSize {
+			top = cursor - pageSize + 1
+		}
+		if linesDrawn > 0 {
+			fmt.Printf("\033[%dA", linesDrawn)
+		}
+		linesDrawn = 0
+		fmt.Printf("\r\033[KFilter: %s\n", filter)
+		linesDrawn++
+		end := top + pageSize
+		if end > len(visible) {
+			end = len(visible)
+		}
+		for i := top; i < end; i++ {
+			fmt.Printf("\r\033[K%s\n", s.render(visible[i], i == cursor))
+			linesDrawn++
+		}
+		fmt.Print("\033[J")
+	}
+
+	redraw()
+	for {
+		key, r, err := readKey(in)
+		if err != nil {
+			return -1, "", err
+		}
+		visible := s.visible(filter)
+		switch key {
+		case keyUp:
+			if cursor > 0 {
+				cursor--
+			}
+		case keyDown:
+			if cursor < len(visible)-1 {
+				cursor++
+			}
+		case keyPgUp:
+			cursor -= pageSize
+			if cursor < 0 {
+				cursor = 0
+			}
+		case keyPgDn:
+			cursor += pageSize
+			if cursor > len(visible)-1 {
+				cursor = len(visible) - 1
+			}
+		case keyEnter:
+			if len(visible) == 0 {
+				redraw()
+				continue
+			}
+			choice := visible[cursor]
+			fmt.Print("\r\n" + s.renderSelected(choice) + "\n")
+			return s.indexOf(choice), choice, nil
+		case keyBackspace:
+			if runes := []rune(filter); len(runes) > 0 {
+				filter = string(runes[:len(runes)-1])
+				cursor = 0
+				top = 0
+			}
+		case keyRune:
+			filter += string(r)
+			cursor = 0
+			top = 0
+		}
+		redraw()
+	}
+}
+
+func (s *Select) fallback() (int, string, error) {
+	p := &Prompter{Message: s.Message, Choices: s.Choices, IsMenu: true}
+	answer := p.Prompt()
+	idx, err := strconv.Atoi(answer)
+	if err != nil {
+		return -1, answer, err
+	}
+	if idx < 1 || idx > len(s.Choices) {
+		return -1, "", fmt.Errorf("prompter: %q is not a valid choice", answer)
+	}
+	return idx - 1, s.Choices[idx-1], nil
+}
+
+func (s *Select) searchFunc() func(input, item string) bool {
+	if s.SearchFunc != nil {
+		return s.SearchFunc
+	}
+	return func(input, item string) bool {
+		return strings.Contains(strings.ToLower(item), strings.ToLower(input))
+	}
+}
+
+func (s *Select) visible(filter string) []string {
+	if filter == "" {
+		return s.Choices
+	}
+	search := s.searchFunc()
+	out := make([]string, 0, len(s.Choices))
+	for _, c := range s.Choices {
+		if search(filter, c) {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+func (s *Select) indexOf(choice string) int {
+	for i, c := range s.Choices {
+		if c == choice {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Select) render(choice string, active bool) string {
+	t := s.Templates
+	if t == nil {
+		if active {
+			return "> " + choice
+		}
+		return "  " + choice
+	}
+	if active && t.Active != "" {
+		return fmt.Sprintf(t.Active, choice)
+	}
+	if !active && t.Inactive != "" {
+		return fmt.Sprintf(t.Inactive, choice)
+	}
+	if active {
+		return "> " + choice
+	}
+	return "  " + choice
+}
+
+// renderSelected formats the final confirmed choice, printed once
+// Enter accepts it.
+func (s *Select) renderSelected(choice string) string {
+	if s.Templates != nil && s.Templates.Selected != "" {
+		return fmt.Sprintf(s.Templates.Selected, choice)
+	}
+	return s.Message + ": " + choice
+}
@@ -0,0 +1,92 @@
+package prompter
+
+import (
+	"context"
+	"os"
+)
+
+// stdinByte pairs one byte read from stdin with any error that ended
+// the read (err is non-nil only once the stream has ended; from then
+// on every item carries that error and a zero byte).
+type stdinByte struct {
+	b   byte
+	err error
+}
+
+// sharedStdin is the single goroutine that ever calls os.Stdin.Read
+// for the process's lifetime. Every prompt path — the plain scanner
+// replacement below, raw-mode key reads in keys.go, password reads in
+// password.go — consumes from it instead of wrapping os.Stdin
+// directly. That means a cancelled PromptContext never leaves a
+// second reader competing with the next prompt for the user's
+// keystrokes: cancellation just stops a select from consuming the
+// channel (see readByteCtx), while this goroutine keeps quietly
+// holding the next byte until whichever prompt asks for it next.
+var sharedStdin = newStdinSource()
+
+type stdinSource struct {
+	bytes chan stdinByte
+}
+
+func newStdinSource() *stdinSource {
+	s := &stdinSource{bytes: make(chan stdinByte)}
+	go s.run()
+	return s
+}
+
+func (s *stdinSource) run() {
+	buf := make([]byte, 1)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if n > 0 {
+			s.bytes <- stdinByte{b: buf[0]}
+		}
+		if err != nil {
+			// Stdin is closed for good; keep handing the same error
+			// to every future reader instead of exiting the goroutine.
+			for {
+				s.bytes <- stdinByte{err: err}
+			}
+		}
+	}
+}
+
+// readByteCtx reads the next byte from the shared stdin stream,
+// returning ctx's error if ctx finishes first. A byte that arrives
+// after ctx wins the race isn't lost: it stays queued in the
+// goroutine above until the next reader — from this prompt's retry,
+// or an entirely new one — receives it.
+func readByteCtx(ctx context.Context) (byte, error) {
+	select {
+	case item := <-sharedStdin.bytes:
+		return item.b, item.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// readByte reads the next byte from the shared stdin stream with no
+// cancellation. No prompt path in this package calls it.
+func readByte() (byte, error) {
+	return readByteCtx(context.Background())
+}
+
+// readLineCtx reads bytes from the shared stdin stream until a
+// newline, trimming the trailing "\r\n"/"\n", the context-aware
+// equivalent of bufio.Scanner's line-at-a-time reads.
+func readLineCtx(ctx context.Context) (string, error) {
+	var line []byte
+	for {
+		b, err := readByteCtx(ctx)
+		if err != nil {
+			return "", err
+		}
+		if b == '\n' {
+			if n := len(line); n > 0 && line[n-1] == '\r' {
+				line = line[:n-1]
+			}
+			return string(line), nil
+		}
+		line = append(line, b)
+	}
+}
@@ -0,0 +1,69 @@
+package prompter
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Required rejects an empty (or whitespace-only) answer.
+func Required(input string) (string, error) {
+	if strings.TrimSpace(input) == "" {
+		return "Answer can't be blank", nil
+	}
+	return "", nil
+}
+
+var emailReg = regexp.MustCompile(`\A[^@\s]+@[^@\s]+\.[^@\s]+\z`)
+
+// IsEmail rejects an answer that doesn't look like an email address.
+func IsEmail(input string) (string, error) {
+	if !emailReg.MatchString(input) {
+		return "Enter a valid email address", nil
+	}
+	return "", nil
+}
+
+// IsURL rejects an answer that isn't a parseable, absolute URL.
+func IsURL(input string) (string, error) {
+	u, err := url.Parse(input)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "Enter a valid URL", nil
+	}
+	return "", nil
+}
+
+// MinLength rejects answers shorter than n runes.
+func MinLength(n int) func(string) (string, error) {
+	return func(input string) (string, error) {
+		if len([]rune(input)) < n {
+			return fmt.Sprintf("Enter at least %d characters", n), nil
+		}
+		return "", nil
+	}
+}
+
+// MaxLength rejects answers longer than n runes.
+func MaxLength(n int) func(string) (string, error) {
+	return func(input string) (string, error) {
+		if len([]rune(input)) > n {
+			return fmt.Sprintf("Enter at most %d characters", n), nil
+		}
+		return "", nil
+	}
+}
+
+// OneOf rejects answers that aren't exactly one of choices. Unlike the
+// Choices field, it runs as a normal validator so it can be combined
+// with other Validators.
+func OneOf(choices ...string) func(string) (string, error) {
+	return func(input string) (string, error) {
+		for _, c := range choices {
+			if input == c {
+				return "", nil
+			}
+		}
+		return fmt.Sprintf("Enter one of: %s", strings.Join(choices, ", ")), nil
+	}
+}
@@ -0,0 +1,93 @@
+package prompter
+
+import "testing"
+
+func TestRequired(t *testing.T) {
+	cases := []struct {
+		input     string
+		complaint bool
+	}{
+		{"", true},
+		{"   ", true},
+		{"x", false},
+	}
+	for _, c := range cases {
+		complaint, err := Required(c.input)
+		if err != nil {
+			t.Fatalf("Required(%q) returned err: %v", c.input, err)
+		}
+		if (complaint != "") != c.complaint {
+			t.Errorf("Required(%q) complaint = %q, want complaint: %v", c.input, complaint, c.complaint)
+		}
+	}
+}
+
+func TestIsEmail(t *testing.T) {
+	cases := map[string]bool{
+		"a@b.com":       true,
+		"a.b+c@d.co.uk": true,
+		"not-an-email":  false,
+		"a@b":           false,
+		"@b.com":        false,
+	}
+	for input, valid := range cases {
+		complaint, err := IsEmail(input)
+		if err != nil {
+			t.Fatalf("IsEmail(%q) returned err: %v", input, err)
+		}
+		if (complaint == "") != valid {
+			t.Errorf("IsEmail(%q) complaint = %q, want valid: %v", input, complaint, valid)
+		}
+	}
+}
+
+func TestIsURL(t *testing.T) {
+	cases := map[string]bool{
+		"https://example.com": true,
+		"http://a.b/c?d=e":    true,
+		"not a url":           false,
+		"/just/a/path":        false,
+	}
+	for input, valid := range cases {
+		complaint, err := IsURL(input)
+		if err != nil {
+			t.Fatalf("IsURL(%q) returned err: %v", input, err)
+		}
+		if (complaint == "") != valid {
+			t.Errorf("IsURL(%q) complaint = %q, want valid: %v", input, complaint, valid)
+		}
+	}
+}
+
+func TestMinLength(t *testing.T) {
+	validate := MinLength(3)
+	if complaint, _ := validate("ab"); complaint == "" {
+		t.Error("MinLength(3)(\"ab\") should complain")
+	}
+	if complaint, _ := validate("abc"); complaint != "" {
+		t.Errorf("MinLength(3)(\"abc\") complained: %q", complaint)
+	}
+	if complaint, _ := validate("éé"); complaint == "" {
+		t.Error("MinLength(3)(\"éé\") should complain: it should count runes, not bytes")
+	}
+}
+
+func TestMaxLength(t *testing.T) {
+	validate := MaxLength(3)
+	if complaint, _ := validate("abcd"); complaint == "" {
+		t.Error("MaxLength(3)(\"abcd\") should complain")
+	}
+	if complaint, _ := validate("abc"); complaint != "" {
+		t.Errorf("MaxLength(3)(\"abc\") complained: %q", complaint)
+	}
+}
+
+func TestOneOf(t *testing.T) {
+	validate := OneOf("red", "green", "blue")
+	if complaint, _ := validate("red"); complaint != "" {
+		t.Errorf("OneOf(...)(\"red\") complained: %q", complaint)
+	}
+	if complaint, _ := validate("purple"); complaint == "" {
+		t.Error("OneOf(...)(\"purple\") should complain")
+	}
+}